@@ -0,0 +1,40 @@
+package treeprint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Path represents the chain of nodes from the root of a tree down to a
+// target node, in top-down order: p[0] is the root and p[len(p)-1] is the
+// target node itself.
+type Path []*Node
+
+// Node returns the node at position x in the path. Negative x indexes from
+// the end, so p.Node(-1) is the target node, p.Node(-2) its parent, and so
+// on. Node returns nil if x is out of range.
+func (p Path) Node(x int) *Node {
+	if x < 0 {
+		x += len(p)
+	}
+	if x < 0 || x >= len(p) {
+		return nil
+	}
+	return p[x]
+}
+
+// Depth returns the depth of the target node within the path, with the
+// root at depth 0.
+func (p Path) Depth() int {
+	return len(p) - 1
+}
+
+// String formats the path as a slash-separated chain of values, e.g.
+// ". / branch / leaf".
+func (p Path) String() string {
+	var parts = make([]string, len(p))
+	for i, node := range p {
+		parts[i] = fmt.Sprintf("%v", node.Value)
+	}
+	return strings.Join(parts, " / ")
+}
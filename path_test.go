@@ -0,0 +1,99 @@
+package treeprint
+
+import "testing"
+
+func TestPathNode(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("leaf")
+
+	path := tree.FindPathByValue("leaf")
+
+	if path.Node(0).Value != "." {
+		t.Fatalf("path.Node(0) = %v, want root value %q", path.Node(0).Value, ".")
+	}
+	if path.Node(1).Value != "a" {
+		t.Fatalf("path.Node(1) = %v, want %q", path.Node(1).Value, "a")
+	}
+	if path.Node(-1).Value != "leaf" {
+		t.Fatalf("path.Node(-1) = %v, want %q", path.Node(-1).Value, "leaf")
+	}
+	if path.Node(-2).Value != "a" {
+		t.Fatalf("path.Node(-2) = %v, want %q", path.Node(-2).Value, "a")
+	}
+}
+
+func TestPathNodeOutOfRange(t *testing.T) {
+	tree := New()
+	path := tree.FindPathByValue("leaf")
+	if path != nil {
+		t.Fatalf("FindPathByValue() = %v, want nil Path for no match", path)
+	}
+	if got := path.Node(0); got != nil {
+		t.Fatalf("Node(0) on empty path = %v, want nil", got)
+	}
+
+	single := Path{&Node{Value: "root"}}
+	if got := single.Node(1); got != nil {
+		t.Fatalf("Node(1) out of range = %v, want nil", got)
+	}
+	if got := single.Node(-2); got != nil {
+		t.Fatalf("Node(-2) out of range = %v, want nil", got)
+	}
+}
+
+func TestPathDepth(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("leaf")
+
+	path := tree.FindPathByValue("leaf")
+	if path.Depth() != 2 {
+		t.Fatalf("path.Depth() = %d, want 2", path.Depth())
+	}
+}
+
+func TestPathString(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("branch")
+	a.AddNode("leaf")
+
+	path := tree.FindPathByValue("leaf")
+	want := ". / branch / leaf"
+	if path.String() != want {
+		t.Fatalf("path.String() = %q, want %q", path.String(), want)
+	}
+}
+
+func TestFindPathByMeta(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddMetaNode("meta-value", "leaf")
+
+	path := tree.FindPathByMeta("meta-value")
+	if path.Node(-1).Value != "leaf" {
+		t.Fatalf("FindPathByMeta() target = %v, want %q", path.Node(-1).Value, "leaf")
+	}
+	if path.Node(-2).Value != "a" {
+		t.Fatalf("FindPathByMeta() parent = %v, want %q", path.Node(-2).Value, "a")
+	}
+
+	if got := tree.FindPathByMeta("missing"); got != nil {
+		t.Fatalf("FindPathByMeta() for missing meta = %v, want nil", got)
+	}
+}
+
+func TestFindPathByValue(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("leaf")
+
+	path := tree.FindPathByValue("leaf")
+	if path.Node(-1).Value != "leaf" {
+		t.Fatalf("FindPathByValue() target = %v, want %q", path.Node(-1).Value, "leaf")
+	}
+
+	if got := tree.FindPathByValue("missing"); got != nil {
+		t.Fatalf("FindPathByValue() for missing value = %v, want nil", got)
+	}
+}
@@ -0,0 +1,56 @@
+package treeprint
+
+import "context"
+
+// SearchFunc is a predicate used by Search and SearchAll. It reports
+// whether n is a match. Returning SkipNode prunes n's subtree from the
+// search; n itself still counts as a match if matched is true. Any other
+// non-nil error aborts the search and is returned to the caller.
+type SearchFunc func(n *Node) (matched bool, err error)
+
+// Search returns the first node (in DFS pre-order) for which fn reports a
+// match, together with the Path from the root down to it. If no node
+// matches, it returns a nil Tree and a nil Path. A non-nil error means fn
+// aborted the search before it could finish; any results gathered so far
+// should not be relied on.
+func (n *Node) Search(fn SearchFunc) (Tree, Path, error) {
+	var found Tree
+	var foundPath Path
+
+	err := n.TreeWalk(context.Background(), WalkHandler{
+		PreChild: func(path Path, parent, child *Node) error {
+			matched, err := fn(child)
+			if err != nil && err != SkipNode {
+				return err
+			}
+			if matched {
+				found = child
+				foundPath = append(path, child)
+				return SkipAll
+			}
+			return err
+		},
+	})
+
+	return found, foundPath, err
+}
+
+// SearchAll returns the Path of every node (in DFS pre-order) for which fn
+// reports a match. A non-nil error means fn aborted the search before it
+// could finish; the returned slice holds only the matches found up to that
+// point.
+func (n *Node) SearchAll(fn SearchFunc) ([]Path, error) {
+	var found []Path
+
+	err := n.TreeWalk(context.Background(), WalkHandler{
+		PreChild: func(path Path, parent, child *Node) error {
+			matched, err := fn(child)
+			if matched {
+				found = append(found, append(append(Path{}, path...), child))
+			}
+			return err
+		},
+	})
+
+	return found, err
+}
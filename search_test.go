@@ -0,0 +1,126 @@
+package treeprint
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSearchFindsFirstMatchWithPath(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("target")
+	tree.AddNode("target")
+
+	found, path, err := tree.Search(func(n *Node) (bool, error) {
+		return n.Value == "target", nil
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v, want nil", err)
+	}
+	if found == nil {
+		t.Fatal("Search() found = nil, want a match")
+	}
+	if path.Node(-1).Value != "target" {
+		t.Fatalf("path target = %v, want %q", path.Node(-1).Value, "target")
+	}
+	if path.Node(-2).Value != "a" {
+		t.Fatalf("path parent = %v, want %q", path.Node(-2).Value, "a")
+	}
+}
+
+func TestSearchAllFindsEveryMatch(t *testing.T) {
+	tree := New()
+	tree.AddBranch("a").AddNode("target")
+	tree.AddNode("target")
+
+	paths, err := tree.SearchAll(func(n *Node) (bool, error) {
+		return n.Value == "target", nil
+	})
+	if err != nil {
+		t.Fatalf("SearchAll() error = %v, want nil", err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("SearchAll() found %d matches, want 2", len(paths))
+	}
+}
+
+func TestSearchAllSkipNodePrunesSubtree(t *testing.T) {
+	tree := New()
+	pruned := tree.AddBranch("pruned")
+	pruned.AddNode("target")
+	tree.AddNode("target")
+
+	paths, err := tree.SearchAll(func(n *Node) (bool, error) {
+		if n.Value == "pruned" {
+			return false, SkipNode
+		}
+		return n.Value == "target", nil
+	})
+	if err != nil {
+		t.Fatalf("SearchAll() error = %v, want nil", err)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("SearchAll() found %d matches, want 1 (pruned subtree should be skipped)", len(paths))
+	}
+	if paths[0].Depth() != 1 {
+		t.Fatalf("match should be a direct child of root, got depth %d", paths[0].Depth())
+	}
+}
+
+func TestSearchAllRecordsMatchEvenWhenPruningItsSubtree(t *testing.T) {
+	tree := New()
+	target := tree.AddBranch("target")
+	target.AddNode("target-child")
+
+	paths, err := tree.SearchAll(func(n *Node) (bool, error) {
+		if n.Value == "target" {
+			return true, SkipNode
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("SearchAll() error = %v, want nil", err)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("SearchAll() found %d matches, want 1 (match plus pruned subtree)", len(paths))
+	}
+	if paths[0].Node(-1).Value != "target" {
+		t.Fatalf("match = %v, want %q", paths[0].Node(-1).Value, "target")
+	}
+}
+
+func TestSearchAllPropagatesPredicateError(t *testing.T) {
+	tree := New()
+	tree.AddNode("one")
+	tree.AddNode("three")
+
+	wantErr := errors.New("boom")
+	paths, err := tree.SearchAll(func(n *Node) (bool, error) {
+		if n.Value == "one" {
+			return false, wantErr
+		}
+		return n.Value == "three", nil
+	})
+	if err != wantErr {
+		t.Fatalf("SearchAll() error = %v, want %v", err, wantErr)
+	}
+	if len(paths) != 0 {
+		t.Fatalf("SearchAll() found %v, want none (walk aborted before reaching a match)", paths)
+	}
+}
+
+func TestSearchPropagatesPredicateError(t *testing.T) {
+	tree := New()
+	tree.AddNode("one")
+
+	wantErr := errors.New("boom")
+	found, path, err := tree.Search(func(n *Node) (bool, error) {
+		return false, wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("Search() error = %v, want %v", err, wantErr)
+	}
+	if found != nil || path != nil {
+		t.Fatalf("Search() = %v, %v, want no match when aborted by error", found, path)
+	}
+}
@@ -3,6 +3,7 @@ package treeprint
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"reflect"
@@ -39,6 +40,23 @@ type Tree interface {
 	// FindByValue finds a Node whose value matches the provided one by reflect.DeepEqual,
 	// returns nil if not found.
 	FindByValue(value any) Tree
+	// FindPathByMeta finds a Node whose meta value matches the provided one by reflect.DeepEqual,
+	// returning the Path from the root down to it. Returns a nil Path if not found.
+	FindPathByMeta(meta any) Path
+	// FindPathByValue finds a Node whose value matches the provided one by reflect.DeepEqual,
+	// returning the Path from the root down to it. Returns a nil Path if not found.
+	FindPathByValue(value any) Path
+	// Search returns the first node (in DFS pre-order) for which fn reports a
+	// match, together with the Path from the root down to it. Returns a nil
+	// Tree and nil Path if no node matches. fn may return SkipNode to prune
+	// a subtree from the search; any other error aborts the search and is
+	// returned to the caller.
+	Search(fn SearchFunc) (Tree, Path, error)
+	// SearchAll returns the Path of every node (in DFS pre-order) for which
+	// fn reports a match. fn may return SkipNode to prune a subtree from the
+	// search; any other error aborts the search and is returned to the
+	// caller.
+	SearchAll(fn SearchFunc) ([]Path, error)
 	//  returns the last Node of a tree
 	FindLastNode() Tree
 	// String renders the tree or subtree as a string.
@@ -53,10 +71,39 @@ type Tree interface {
 
 	SetMetaValue(meta any)
 
-	// VisitAll iterates over the tree, branches and nodes.
-	// If need to iterate over the whole tree, use the root Node.
-	// Note this method uses a breadth-first approach.
+	// VisitAll iterates over the tree, branches and nodes, in pre-order
+	// depth-first order. If need to iterate over the whole tree, use the
+	// root Node.
+	//
+	// Deprecated: use VisitDFS instead; the name VisitAll predates the
+	// BFS/DFS split and its behavior has always been depth-first.
 	VisitAll(fn NodeVisitor)
+
+	// VisitDFS iterates over the tree, branches and nodes, in pre-order
+	// depth-first order: a node is visited before any of its siblings
+	// further down the tree, but after its own ancestors.
+	VisitDFS(fn NodeVisitor)
+
+	// VisitDFSPost iterates over the tree, branches and nodes, in
+	// post-order depth-first order: a node is visited only after all of
+	// its descendants have been visited. Useful for computing aggregates
+	// from the leaves upward.
+	VisitDFSPost(fn NodeVisitor)
+
+	// VisitBFS iterates over the tree, branches and nodes, in level order:
+	// every node at depth d is visited before any node at depth d+1.
+	VisitBFS(fn NodeVisitor)
+
+	// TreeWalk walks the tree rooted at this node in depth-first order,
+	// invoking the callbacks in cbs. See WalkHandler for the exact
+	// lifecycle. The walk aborts with ctx.Err() as soon as ctx is
+	// cancelled.
+	TreeWalk(ctx context.Context, cbs WalkHandler) error
+
+	// Validate walks the tree structure and reports cycles, inconsistent
+	// Root back-pointers, and duplicate node pointers appearing under more
+	// than one parent. An empty slice means the structure is well-formed.
+	Validate() []TreeError
 }
 
 type Node struct {
@@ -155,9 +202,38 @@ func (n *Node) FindByValue(value any) Tree {
 	return nil
 }
 
+func (n *Node) FindPathByMeta(meta any) Path {
+	var found Path
+	_ = n.TreeWalk(context.Background(), WalkHandler{
+		PreChild: func(path Path, parent, child *Node) error {
+			if reflect.DeepEqual(child.Meta, meta) {
+				found = append(path, child)
+				return SkipAll
+			}
+			return nil
+		},
+	})
+	return found
+}
+
+func (n *Node) FindPathByValue(value any) Path {
+	var found Path
+	_ = n.TreeWalk(context.Background(), WalkHandler{
+		PreChild: func(path Path, parent, child *Node) error {
+			if reflect.DeepEqual(child.Value, value) {
+				found = append(path, child)
+				return SkipAll
+			}
+			return nil
+		},
+	})
+	return found
+}
+
 func (n *Node) Writer(w io.Writer) {
 	var level = 0
 	var levelsEnded []int
+	var visited = map[*Node]bool{n: true}
 	if n.Root == nil {
 		if n.Meta != nil {
 			fmt.Fprintf(w, "[%v]  %v", n.Meta, n.Value)
@@ -174,7 +250,7 @@ func (n *Node) Writer(w io.Writer) {
 		printValues(w, 0, levelsEnded, edge, n)
 	}
 	if len(n.Nodes) > 0 {
-		printNodes(w, level, levelsEnded, n.Nodes)
+		printNodes(w, level, levelsEnded, n.Nodes, visited)
 	}
 }
 
@@ -200,29 +276,41 @@ func (n *Node) SetMetaValue(meta any) {
 	n.Meta = meta
 }
 
+// VisitAll is a deprecated alias for VisitDFS.
 func (n *Node) VisitAll(fn NodeVisitor) {
-	for _, node := range n.Nodes {
-		fn(node)
-
-		if len(node.Nodes) > 0 {
-			node.VisitAll(fn)
-			continue
-		}
-	}
+	n.VisitDFS(fn)
 }
 
-func printNodes(wr io.Writer, level int, levelsEnded []int, nodes []*Node) {
+func printNodes(wr io.Writer, level int, levelsEnded []int, nodes []*Node, visited map[*Node]bool) {
 	for i, node := range nodes {
 		var edge = EdgeTypeMid
 		if i == len(nodes)-1 {
 			levelsEnded = append(levelsEnded, level)
 			edge = EdgeTypeEnd
 		}
+		if visited[node] {
+			printCycleMarker(wr, level, levelsEnded, edge, node)
+			continue
+		}
+		visited[node] = true
 		printValues(wr, level, levelsEnded, edge, node)
 		if len(node.Nodes) > 0 {
-			printNodes(wr, level+1, levelsEnded, node.Nodes)
+			printNodes(wr, level+1, levelsEnded, node.Nodes, visited)
+		}
+	}
+}
+
+// printCycleMarker renders a placeholder for a node that has already been
+// rendered elsewhere in the tree, instead of descending into it again.
+func printCycleMarker(wr io.Writer, level int, levelsEnded []int, edge EdgeType, node *Node) {
+	for i := 0; i < level; i++ {
+		if isEnded(levelsEnded, i) {
+			fmt.Fprint(wr, strings.Repeat(" ", IndentSize+1))
+			continue
 		}
+		fmt.Fprintf(wr, "%s%s", EdgeTypeLink, strings.Repeat(" ", IndentSize))
 	}
+	fmt.Fprintf(wr, "%s <cycle to: %v>\n", edge, node.Value)
 }
 
 func printValues(wr io.Writer, level int, levelsEnded []int, edge EdgeType, node *Node) {
@@ -280,7 +368,10 @@ func renderValue(level int, node *Node) any {
 func padding(level int, node *Node) string {
 	var links = make([]string, level+1)
 
-	for node.Root != nil {
+	// level bounds the number of ancestors a well-formed tree has at this
+	// depth, so it also bounds this loop: that keeps a cyclic or otherwise
+	// inconsistent Root chain from running links[level] negative.
+	for level >= 0 && node.Root != nil {
 		if isLast(node) {
 			links[level] = strings.Repeat(" ", IndentSize+1)
 		} else {
@@ -0,0 +1,39 @@
+package treeprint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriterRendersCycleMarkerInsteadOfLooping(t *testing.T) {
+	root := &Node{Value: "root"}
+	a := &Node{Root: root, Value: "a"}
+	root.Nodes = []*Node{a, root} // root reappears under itself
+
+	out := root.String()
+
+	if !strings.Contains(out, "<cycle to: root>") {
+		t.Fatalf("String() = %q, want a cycle marker for the repeated root node", out)
+	}
+}
+
+func TestWriterDoesNotPanicOnCyclicRootChain(t *testing.T) {
+	// a and b form a cycle through Root, independent of the Nodes-based
+	// traversal path that actually reaches a. This mimics an inconsistent
+	// Root back-pointer as reported by Validate.
+	a := &Node{Value: "line1\nline2"}
+	b := &Node{Value: "b"}
+	a.Root = b
+	b.Root = a
+
+	root := &Node{Value: "root"}
+	branch := &Node{Root: root, Value: "branch", Nodes: []*Node{a}}
+	root.Nodes = []*Node{branch}
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("String() panicked: %v", r)
+		}
+	}()
+	_ = root.String()
+}
@@ -0,0 +1,69 @@
+package treeprint
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TreeError describes a structural problem found in a tree by Validate.
+type TreeError struct {
+	Path Path
+	Err  error
+}
+
+func (e TreeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e TreeError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// ErrCycle indicates a node is reachable from itself via Nodes.
+	ErrCycle = errors.New("treeprint: cycle detected")
+	// ErrRootMismatch indicates a child's Root does not point back to its
+	// actual parent.
+	ErrRootMismatch = errors.New("treeprint: child Root does not point to its parent")
+	// ErrDuplicateNode indicates the same *Node appears under more than one
+	// parent's Nodes slice.
+	ErrDuplicateNode = errors.New("treeprint: node appears under more than one parent")
+)
+
+// Validate walks the tree structure and reports cycles (a node reachable
+// from itself via Nodes), inconsistent Root back-pointers, and duplicate
+// node pointers appearing in more than one parent's Nodes slice.
+func (n *Node) Validate() []TreeError {
+	var errs []TreeError
+	var onPath = map[*Node]bool{}
+	var seen = map[*Node]bool{}
+
+	var walk func(path Path, node *Node)
+	walk = func(path Path, node *Node) {
+		onPath[node] = true
+		defer delete(onPath, node)
+
+		for _, child := range node.Nodes {
+			childPath := append(append(Path{}, path...), child)
+
+			if onPath[child] {
+				errs = append(errs, TreeError{Path: childPath, Err: ErrCycle})
+				continue
+			}
+
+			if seen[child] {
+				errs = append(errs, TreeError{Path: childPath, Err: ErrDuplicateNode})
+			}
+			seen[child] = true
+
+			if child.Root != node {
+				errs = append(errs, TreeError{Path: childPath, Err: ErrRootMismatch})
+			}
+
+			walk(childPath, child)
+		}
+	}
+	walk(Path{n}, n)
+
+	return errs
+}
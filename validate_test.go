@@ -0,0 +1,59 @@
+package treeprint
+
+import "testing"
+
+func TestValidateCleanTreeHasNoErrors(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("leaf")
+
+	errs := tree.Validate()
+	if len(errs) != 0 {
+		t.Fatalf("Validate() = %v, want no errors", errs)
+	}
+}
+
+func TestValidateDetectsCycle(t *testing.T) {
+	root := &Node{Value: "root"}
+	a := &Node{Root: root, Value: "a"}
+	root.Nodes = []*Node{a}
+	a.Nodes = []*Node{root} // a points back to root: cycle
+
+	errs := root.Validate()
+	if !containsErr(errs, ErrCycle) {
+		t.Fatalf("Validate() = %v, want an ErrCycle", errs)
+	}
+}
+
+func TestValidateDetectsRootMismatch(t *testing.T) {
+	root := &Node{Value: "root"}
+	child := &Node{Root: nil, Value: "child"} // should point to root, but doesn't
+	root.Nodes = []*Node{child}
+
+	errs := root.Validate()
+	if !containsErr(errs, ErrRootMismatch) {
+		t.Fatalf("Validate() = %v, want an ErrRootMismatch", errs)
+	}
+}
+
+func TestValidateDetectsDuplicateNode(t *testing.T) {
+	root := &Node{Value: "root"}
+	shared := &Node{Root: root, Value: "shared"}
+	branchA := &Node{Root: root, Value: "a", Nodes: []*Node{shared}}
+	branchB := &Node{Root: root, Value: "b", Nodes: []*Node{shared}}
+	root.Nodes = []*Node{branchA, branchB}
+
+	errs := root.Validate()
+	if !containsErr(errs, ErrDuplicateNode) {
+		t.Fatalf("Validate() = %v, want an ErrDuplicateNode", errs)
+	}
+}
+
+func containsErr(errs []TreeError, target error) bool {
+	for _, e := range errs {
+		if e.Err == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package treeprint
+
+import "context"
+
+// VisitDFS iterates over the tree, branches and nodes, in pre-order
+// depth-first order: a node is visited before any of its siblings further
+// down the tree, but after its own ancestors.
+func (n *Node) VisitDFS(fn NodeVisitor) {
+	_ = n.TreeWalk(context.Background(), WalkHandler{
+		PreChild: func(path Path, parent, child *Node) error {
+			fn(child)
+			return nil
+		},
+	})
+}
+
+// VisitDFSPost iterates over the tree, branches and nodes, in post-order
+// depth-first order: a node is visited only after all of its descendants
+// have been visited.
+func (n *Node) VisitDFSPost(fn NodeVisitor) {
+	_ = n.TreeWalk(context.Background(), WalkHandler{
+		PostChild: func(path Path, parent, child *Node) error {
+			fn(child)
+			return nil
+		},
+	})
+}
+
+// VisitBFS iterates over the tree, branches and nodes, in level order:
+// every node at depth d is visited before any node at depth d+1.
+func (n *Node) VisitBFS(fn NodeVisitor) {
+	queue := append([]*Node{}, n.Nodes...)
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		fn(node)
+
+		queue = append(queue, node.Nodes...)
+	}
+}
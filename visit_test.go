@@ -0,0 +1,69 @@
+package treeprint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func buildVisitTestTree() Tree {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("a1")
+	a.AddNode("a2")
+	tree.AddNode("b")
+	return tree
+}
+
+func TestVisitDFSPreOrder(t *testing.T) {
+	tree := buildVisitTestTree()
+
+	var visited []string
+	tree.VisitDFS(func(n *Node) {
+		visited = append(visited, n.Value.(string))
+	})
+
+	want := []string{"a", "a1", "a2", "b"}
+	if !reflect.DeepEqual(visited, want) {
+		t.Fatalf("VisitDFS() visited = %v, want %v", visited, want)
+	}
+}
+
+func TestVisitBFSLevelOrder(t *testing.T) {
+	tree := buildVisitTestTree()
+
+	var visited []string
+	tree.VisitBFS(func(n *Node) {
+		visited = append(visited, n.Value.(string))
+	})
+
+	want := []string{"a", "b", "a1", "a2"}
+	if !reflect.DeepEqual(visited, want) {
+		t.Fatalf("VisitBFS() visited = %v, want %v", visited, want)
+	}
+}
+
+func TestVisitDFSPostOrder(t *testing.T) {
+	tree := buildVisitTestTree()
+
+	var visited []string
+	tree.VisitDFSPost(func(n *Node) {
+		visited = append(visited, n.Value.(string))
+	})
+
+	want := []string{"a1", "a2", "a", "b"}
+	if !reflect.DeepEqual(visited, want) {
+		t.Fatalf("VisitDFSPost() visited = %v, want %v", visited, want)
+	}
+}
+
+func TestVisitAllMatchesVisitDFS(t *testing.T) {
+	tree := buildVisitTestTree()
+
+	var dfsVisited, allVisited []string
+	tree.VisitDFS(func(n *Node) { dfsVisited = append(dfsVisited, n.Value.(string)) })
+	tree.VisitAll(func(n *Node) { allVisited = append(allVisited, n.Value.(string)) })
+
+	if !reflect.DeepEqual(allVisited, dfsVisited) {
+		t.Fatalf("VisitAll() visited = %v, want same as VisitDFS() = %v", allVisited, dfsVisited)
+	}
+}
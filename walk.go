@@ -0,0 +1,114 @@
+package treeprint
+
+import (
+	"context"
+	"errors"
+)
+
+// WalkHandler holds the optional callbacks invoked by TreeWalk. Any field
+// may be left nil, in which case the corresponding step of the walk is
+// simply skipped.
+//
+// The lifecycle for a branch node n is: PreNode(n), then for each child:
+// PreChild(n, child), recurse into child, PostChild(n, child); finally
+// PostNode(n). Leaf nodes (len(n.Nodes) == 0) fire Leaf(n) instead of
+// PreNode/PostNode, and are never themselves the parent in a PreChild or
+// PostChild call.
+type WalkHandler struct {
+	// PreNode is called when descending into a branch node, before any of
+	// its children are visited.
+	PreNode func(path Path, n *Node) error
+	// PostNode is called after all of a branch node's children have been
+	// visited.
+	PostNode func(path Path, n *Node) error
+	// PreChild is called before recursing into a child node.
+	PreChild func(path Path, parent, child *Node) error
+	// PostChild is called after recursing into a child node.
+	PostChild func(path Path, parent, child *Node) error
+	// Leaf is called for nodes with no children, in place of PreNode/PostNode.
+	Leaf func(path Path, n *Node) error
+}
+
+var (
+	// SkipNode, returned from a WalkHandler callback, skips descent into
+	// the node's subtree without stopping the rest of the walk.
+	SkipNode = errors.New("treeprint: skip node")
+	// SkipAll, returned from a WalkHandler callback, stops the walk
+	// cleanly; TreeWalk itself returns nil in this case.
+	SkipAll = errors.New("treeprint: skip all")
+)
+
+// TreeWalk walks the tree rooted at n in strict depth-first order, invoking
+// the callbacks in cbs. It checks ctx between callbacks and aborts with
+// ctx.Err() as soon as the context is cancelled.
+func (n *Node) TreeWalk(ctx context.Context, cbs WalkHandler) error {
+	err := n.walk(ctx, nil, cbs)
+	if err == SkipNode || err == SkipAll {
+		return nil
+	}
+	return err
+}
+
+func (n *Node) walk(ctx context.Context, path Path, cbs WalkHandler) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path = append(append(Path{}, path...), n)
+
+	if len(n.Nodes) == 0 {
+		if cbs.Leaf != nil {
+			if err := cbs.Leaf(path, n); err != nil {
+				if err == SkipNode {
+					return nil
+				}
+				return err
+			}
+		}
+		return nil
+	}
+
+	if cbs.PreNode != nil {
+		if err := cbs.PreNode(path, n); err != nil {
+			if err == SkipNode {
+				return nil
+			}
+			return err
+		}
+	}
+
+	for _, child := range n.Nodes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if cbs.PreChild != nil {
+			if err := cbs.PreChild(path, n, child); err != nil {
+				if err == SkipNode {
+					continue
+				}
+				return err
+			}
+		}
+
+		if err := child.walk(ctx, path, cbs); err != nil {
+			return err
+		}
+
+		if cbs.PostChild != nil {
+			if err := cbs.PostChild(path, n, child); err != nil {
+				if err == SkipNode {
+					continue
+				}
+				return err
+			}
+		}
+	}
+
+	if cbs.PostNode != nil {
+		if err := cbs.PostNode(path, n); err != nil && err != SkipNode {
+			return err
+		}
+	}
+	return nil
+}
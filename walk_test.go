@@ -0,0 +1,105 @@
+package treeprint
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTreeWalkLeafSkipNodeDoesNotAbortWalk(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("leaf-under-a")
+	tree.AddNode("sibling-leaf")
+
+	var visited []string
+	err := tree.TreeWalk(context.Background(), WalkHandler{
+		Leaf: func(path Path, n *Node) error {
+			visited = append(visited, n.Value.(string))
+			if n.Value == "leaf-under-a" {
+				return SkipNode
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("TreeWalk() error = %v, want nil", err)
+	}
+
+	want := []string{"leaf-under-a", "sibling-leaf"}
+	if len(visited) != len(want) {
+		t.Fatalf("visited = %v, want %v", visited, want)
+	}
+	for i := range want {
+		if visited[i] != want[i] {
+			t.Fatalf("visited = %v, want %v", visited, want)
+		}
+	}
+}
+
+func TestTreeWalkPreNodeSkipNodeSkipsSubtreeOnly(t *testing.T) {
+	tree := New()
+	a := tree.AddBranch("a")
+	a.AddNode("leaf-under-a")
+	tree.AddNode("sibling-leaf")
+
+	var visited []string
+	err := tree.TreeWalk(context.Background(), WalkHandler{
+		PreNode: func(path Path, n *Node) error {
+			if n.Value == "a" {
+				return SkipNode
+			}
+			return nil
+		},
+		PreChild: func(path Path, parent, child *Node) error {
+			visited = append(visited, child.Value.(string))
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("TreeWalk() error = %v, want nil", err)
+	}
+
+	want := []string{"a", "sibling-leaf"}
+	if len(visited) != len(want) {
+		t.Fatalf("visited = %v, want %v", visited, want)
+	}
+	for i := range want {
+		if visited[i] != want[i] {
+			t.Fatalf("visited = %v, want %v", visited, want)
+		}
+	}
+}
+
+func TestTreeWalkSkipAllStopsImmediately(t *testing.T) {
+	tree := New()
+	tree.AddNode("one")
+	tree.AddNode("two")
+
+	var visited []string
+	err := tree.TreeWalk(context.Background(), WalkHandler{
+		Leaf: func(path Path, n *Node) error {
+			visited = append(visited, n.Value.(string))
+			return SkipAll
+		},
+	})
+	if err != nil {
+		t.Fatalf("TreeWalk() error = %v, want nil", err)
+	}
+	if len(visited) != 1 {
+		t.Fatalf("visited = %v, want exactly one node visited", visited)
+	}
+}
+
+func TestTreeWalkContextCancellation(t *testing.T) {
+	tree := New()
+	tree.AddNode("one")
+	tree.AddNode("two")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := tree.TreeWalk(ctx, WalkHandler{})
+	if err != context.Canceled {
+		t.Fatalf("TreeWalk() error = %v, want context.Canceled", err)
+	}
+}